@@ -10,11 +10,20 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	config "github.com/eeritvan/unari-ssh/pkg/config"
 	fetch "github.com/eeritvan/unari-ssh/pkg/fetch"
+	store "github.com/eeritvan/unari-ssh/pkg/store"
+	watch "github.com/eeritvan/unari-ssh/pkg/watch"
 
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
@@ -26,7 +35,77 @@ import (
 	"github.com/joho/godotenv"
 )
 
-var data []fetch.Unicafe
+// fetchClient is shared across sessions so its menu cache and background
+// refresh benefit every connected user instead of being rebuilt per session.
+var fetchClient = fetch.NewClient(fetch.DefaultTimeout)
+
+// availableProviders is the set of restaurant backends currently enabled,
+// rebuilt from config whenever cfgWatcher reloads it and shared read-only
+// with every session that connects afterwards.
+var availableProviders atomic.Pointer[[]fetch.Provider]
+
+// cfgWatcher hot-reloads config.yaml and .env; it's set once in main and
+// read by every session to pick up the current welcome message and to
+// subscribe to future reloads.
+var cfgWatcher *watch.Watcher
+
+// prefStore persists per-user preferences across sessions, keyed by SSH
+// public key fingerprint. It's set in main once the data directory is known;
+// unlike the other settings, the data directory isn't hot-reloadable.
+var prefStore store.Store
+
+// preferencesWriteDelay debounces preference saves so rapid changes within a
+// session (e.g. toggling several diet filters in a row) cost one write.
+const preferencesWriteDelay = 2 * time.Second
+
+func today() string {
+	return time.Now().Format("2006-01-02")
+}
+
+// setAvailableProviders atomically swaps the shared provider set, used both
+// at startup and whenever cfgWatcher reloads a new enabled-providers list.
+func setAvailableProviders(providers []fetch.Provider) {
+	availableProviders.Store(&providers)
+}
+
+func currentProviders() []fetch.Provider {
+	if p := availableProviders.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// waitForConfig turns the next value on a Watcher subscription channel into
+// a tea.Msg, the standard Bubble Tea pattern for bridging an external event
+// source into Update. Callers must re-issue it after every ConfigMsg to keep
+// listening.
+func waitForConfig(ch <-chan config.Config) tea.Cmd {
+	return func() tea.Msg {
+		cfg, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return watch.ConfigMsg{Config: cfg}
+	}
+}
+
+// buildProviders turns a list of enabled provider names into Provider
+// instances. Unicafe is special-cased because it needs the shared,
+// cache-backed fetchClient rather than a fresh instance per session; every
+// other provider is looked up in the package's registry.
+func buildProviders(cfg config.Config) []fetch.Provider {
+	enabled := make(map[string]bool, len(cfg.EnabledProviders))
+	for _, name := range cfg.EnabledProviders {
+		enabled[name] = true
+	}
+
+	var providers []fetch.Provider
+	if enabled["unicafe"] {
+		providers = append(providers, fetch.NewUnicafeProvider(fetchClient))
+	}
+	providers = append(providers, fetch.NewEnabledProviders(cfg.EnabledProviders)...)
+	return providers
+}
 
 type viewType int
 
@@ -37,18 +116,55 @@ const (
 	totalViews
 )
 
+// viewName and viewFromName let the current view be persisted as a
+// Preferences.LastView string, which is more stable across code changes than
+// saving the bare viewType int would be.
+func viewName(v viewType) string {
+	switch v {
+	case homeView:
+		return "home"
+	case restaurantView:
+		return "restaurants"
+	case terminalInfoView:
+		return "terminalInfo"
+	}
+	return ""
+}
+
+func viewFromName(name string) (viewType, bool) {
+	switch name {
+	case "home":
+		return homeView, true
+	case "restaurants":
+		return restaurantView, true
+	case "terminalInfo":
+		return terminalInfoView, true
+	}
+	return 0, false
+}
+
 func main() {
 	err := godotenv.Load()
 	if err != nil {
 		log.Fatal("Error loading .env file")
 	}
 
+	const hostKeyPath = ".ssh/id_ed25519"
+
+	w, err := watch.New(hostKeyPath)
+	if err != nil {
+		log.Fatal("Invalid initial config", "error", err)
+	}
+	cfgWatcher = w
+	setAvailableProviders(buildProviders(cfgWatcher.Current()))
+	prefStore = store.NewFileStore(cfgWatcher.Current().DataDir)
+
 	host := os.Getenv("HOST")
 	port := os.Getenv("PORT")
 
 	s, err := wish.NewServer(
 		wish.WithAddress(net.JoinHostPort(host, port)),
-		wish.WithHostKeyPath(".ssh/id_ed25519"),
+		wish.WithHostKeyPath(hostKeyPath),
 		wish.WithMiddleware(
 			bubbletea.Middleware(teaHandler),
 			activeterm.Middleware(),
@@ -59,6 +175,28 @@ func main() {
 		log.Error("Could not start server", "error", err)
 	}
 
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+	if err := cfgWatcher.Start(watchCtx); err != nil {
+		log.Error("Could not start config watcher", "error", err)
+	}
+
+	bgCtx, stopBackgroundRefresh := context.WithCancel(context.Background())
+	defer stopBackgroundRefresh()
+	fetchClient.StartDynamicBackgroundRefresh(bgCtx, today(), func() time.Duration {
+		return cfgWatcher.Current().RefreshInterval
+	})
+
+	// Rebuilding the provider set needs its own subscription (separate from
+	// each session's) since it outlives any single session.
+	reloads, cancelReloads := cfgWatcher.Subscribe()
+	defer cancelReloads()
+	go func() {
+		for cfg := range reloads {
+			setAvailableProviders(buildProviders(cfg))
+		}
+	}()
+
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	log.Info("Starting SSH server", "host", host, "port", port)
@@ -102,6 +240,38 @@ func teaHandler(s ssh.Session) (tea.Model, []tea.ProgramOption) {
 		bg = "dark"
 	}
 
+	sp := spinner.New()
+	sp.Spinner = spinner.Dot
+
+	prefKey := ""
+	if pk := s.PublicKey(); pk != nil {
+		prefKey = store.Fingerprint(pk)
+	}
+	sessionStore := prefStore
+	if prefKey == "" {
+		// No public key to key preferences on (e.g. password or keyboard-
+		// interactive auth); don't persist anything for this session.
+		sessionStore = store.Discard
+	}
+	prefs, err := sessionStore.Load(prefKey)
+	if err != nil {
+		log.Error("Could not load preferences", "error", err)
+		prefs = store.Preferences{}
+	}
+	prefsWriter := store.NewWriter(sessionStore, prefKey, preferencesWriteDelay)
+	prefsWriter.OnError = func(err error) {
+		log.Error("Could not save preferences", "error", err)
+	}
+
+	configUpdates, cancelConfigUpdates := cfgWatcher.Subscribe()
+	go func() {
+		<-s.Context().Done()
+		prefsWriter.Flush()
+		cancelConfigUpdates()
+	}()
+
+	providers := currentProviders()
+
 	m := model{
 		term:         pty.Term,
 		profile:      renderer.ColorProfile().Name(),
@@ -114,7 +284,26 @@ func teaHandler(s ssh.Session) (tea.Model, []tea.ProgramOption) {
 		navStyle:     navStyle,
 		sidebarStyle: sidebarStyle,
 		currentView:  homeView,
+		day:          today(),
+		spinner:      sp,
+		loadingMenu:  true,
+
+		restaurantList: newRestaurantList(),
+		menuList:       newMenuList(),
+		dishViewport:   newDishViewport(),
+		searchInput:    newSearchInput(),
+		activeDiets:    map[fetch.Diet]bool{},
+
+		providers:        providers,
+		enabledProviders: allEnabled(providers),
+
+		prefsWriter:    prefsWriter,
+		welcomeMessage: cfgWatcher.Current().WelcomeMessage,
+		configUpdates:  configUpdates,
 	}
+	m = m.applyPreferences(prefs)
+	m = m.setSize(m.width, m.height)
+	m.syncPrefs()
 	return m, []tea.ProgramOption{tea.WithAltScreen()}
 }
 
@@ -130,10 +319,135 @@ type model struct {
 	navStyle     lipgloss.Style
 	sidebarStyle lipgloss.Style
 	currentView  viewType
+
+	day         string
+	spinner     spinner.Model
+	restaurants []fetch.Restaurant
+	loadingMenu bool
+	menuErr     error
+	menuStale   bool
+	menuWarning error
+
+	drill              drillState
+	restaurantList     list.Model
+	menuList           list.Model
+	dishViewport       viewport.Model
+	searchInput        textinput.Model
+	activeDiets        map[fetch.Diet]bool
+	selectedRestaurant fetch.Restaurant
+	selectedDish       fetch.Dish
+	allDishes          []fetch.Dish
+
+	providers        []fetch.Provider
+	enabledProviders map[string]bool
+
+	favorites       map[string]bool
+	preferredCampus string
+	prefsWriter     *store.Writer
+
+	lastViewedDate  string
+	lastColorScheme string
+
+	welcomeMessage string
+	configUpdates  <-chan config.Config
+}
+
+// applyPreferences hydrates the parts of initial model state a returning
+// user asked to remember: favorite restaurants, preferred campus, dietary
+// filters, which top-level view they were last on, and when/with what color
+// profile their last session ran, so the home and terminal-info views can
+// show them back.
+func (m model) applyPreferences(prefs store.Preferences) model {
+	m.favorites = favoritesFromNames(prefs.FavoriteRestaurants)
+	m.preferredCampus = prefs.PreferredCampus
+	m.activeDiets = dietsFromNames(prefs.DietaryFilters)
+	if v, ok := viewFromName(prefs.LastView); ok {
+		m.currentView = v
+	}
+	m.lastViewedDate = prefs.LastViewedDate
+	m.lastColorScheme = prefs.ColorScheme
+	return m
+}
+
+// syncPrefs persists the current favorites, preferred campus, dietary
+// filters, view, today's date and color profile through prefsWriter, which
+// debounces the actual write.
+func (m model) syncPrefs() {
+	if m.prefsWriter == nil {
+		return
+	}
+	m.prefsWriter.Set(store.Preferences{
+		FavoriteRestaurants: favoriteNames(m.favorites),
+		PreferredCampus:     m.preferredCampus,
+		DietaryFilters:      dietNames(m.activeDiets),
+		LastView:            viewName(m.currentView),
+		LastViewedDate:      m.day,
+		ColorScheme:         m.profile,
+	})
+}
+
+// allEnabled turns on every provider by default; users can toggle individual
+// providers off for the rest of their session from the sidebar.
+func allEnabled(providers []fetch.Provider) map[string]bool {
+	enabled := make(map[string]bool, len(providers))
+	for _, p := range providers {
+		enabled[p.Name()] = true
+	}
+	return enabled
+}
+
+// activeProviders returns the providers the user currently has enabled.
+func (m model) activeProviders() []fetch.Provider {
+	active := make([]fetch.Provider, 0, len(m.providers))
+	for _, p := range m.providers {
+		if m.enabledProviders[p.Name()] {
+			active = append(active, p)
+		}
+	}
+	return active
+}
+
+// pageMsg carries the result of an asynchronous menu fetch back into Update,
+// mirroring the FetchPage/PageMsg pattern so renderRestaurantView never has
+// to block the SSH session on network I/O. warning is set when some (but not
+// all) providers failed: restaurants is still worth showing, just incomplete.
+type pageMsg struct {
+	restaurants []fetch.Restaurant
+	warning     error
+}
+
+// errMsg carries a failed menu fetch back into Update.
+type errMsg struct{ err error }
+
+// anyStale reports whether any restaurant in the page came from a cache
+// entry that's being refreshed in the background, so the TUI can tell the
+// user they're looking at slightly old data.
+func anyStale(restaurants []fetch.Restaurant) bool {
+	for _, r := range restaurants {
+		if r.Stale {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchPage asynchronously loads and merges every enabled provider's
+// restaurants. A provider failing doesn't fail the whole page: as long as at
+// least one provider came back with something, that's returned as a pageMsg
+// with the failure attached as a non-fatal warning. Only a total wipeout,
+// where every provider failed, is reported as errMsg.
+func fetchPage(providers []fetch.Provider) tea.Cmd {
+	return func() tea.Msg {
+		restaurants, err := fetch.FetchAll(context.Background(), providers)
+		if err != nil && len(restaurants) == 0 {
+			return errMsg{err}
+		}
+		return pageMsg{restaurants: restaurants, warning: err}
+	}
 }
 
 func (m model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(m.spinner.Tick, fetchPage(m.activeProviders()), waitForConfig(m.configUpdates))
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -141,7 +455,11 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.height = msg.Height
 		m.width = msg.Width
+		m = m.setSize(msg.Width, msg.Height)
 	case tea.KeyMsg:
+		if m.currentView == restaurantView {
+			return m.updateRestaurantView(msg)
+		}
 		switch msg.String() {
 		case "q", "ctrl+c":
 			return m, tea.Quit
@@ -150,11 +468,35 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.currentView < 0 {
 				m.currentView = totalViews - 1
 			}
+			m.syncPrefs()
 		case "down", "j":
 			m.currentView++
 			if m.currentView >= totalViews {
 				m.currentView = 0
 			}
+			m.syncPrefs()
+		}
+	case pageMsg:
+		m.loadingMenu = false
+		m.menuErr = nil
+		m.menuWarning = msg.warning
+		m.restaurants = msg.restaurants
+		m.menuStale = anyStale(msg.restaurants)
+		if m.drill == drillRestaurants {
+			merged := mergeByCampus(msg.restaurants, m.preferredCampus, m.favorites)
+			m.restaurantList.SetItems(restaurantListItems(merged, m.favorites))
+		}
+	case errMsg:
+		m.loadingMenu = false
+		m.menuErr = msg.err
+	case watch.ConfigMsg:
+		m.welcomeMessage = msg.Config.WelcomeMessage
+		return m, waitForConfig(m.configUpdates)
+	case spinner.TickMsg:
+		if m.loadingMenu {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			return m, cmd
 		}
 	}
 	return m, nil
@@ -181,29 +523,61 @@ func (m model) View() string {
 
 func (m model) renderHomeView() string {
 	title := m.titleStyle.Render("view 1")
-	content := m.txtStyle.Render("yo yo yo.")
+	content := m.txtStyle.Render(m.welcomeMessage)
+	if m.lastViewedDate != "" && m.lastViewedDate != m.day {
+		content += m.txtStyle.Render(fmt.Sprintf("\n\nLast visited: %s", m.lastViewedDate))
+	}
 	return title + content
 }
 
 func (m model) renderRestaurantView() string {
 	title := m.titleStyle.Render("view 2")
 
-	if len(data) == 0 {
-		var err error
-		restaurants, err := fetch.GetUnicafe()
-		if err != nil {
-			return title + "\n" + m.txtStyle.Render(fmt.Sprintf("\nError loading restaurants: %v", err))
-		}
-		data = restaurants
+	if m.menuErr != nil {
+		return title + "\n" + m.txtStyle.Render(fmt.Sprintf("\nError loading restaurants: %v", m.menuErr))
 	}
 
-	var restaurantList string
-	for index, restaurant := range data {
-		restaurantList += fmt.Sprintf("\n  %d. %s", index+1, restaurant.Title)
+	if m.loadingMenu && len(m.restaurants) == 0 {
+		return title + "\n" + m.txtStyle.Render(fmt.Sprintf("\n%s Loading restaurants...", m.spinner.View()))
 	}
 
-	content := m.txtStyle.Render(restaurantList)
-	return title + content
+	switch m.drill {
+	case drillRestaurants:
+		body := lipgloss.JoinHorizontal(lipgloss.Top, m.restaurantList.View(), m.renderProviderSidebar())
+		if m.menuStale {
+			body += "\n\n(showing cached data, refreshing...)"
+		}
+		if m.menuWarning != nil {
+			body += fmt.Sprintf("\n\n(some providers failed to load: %v)", m.menuWarning)
+		}
+		return title + "\n" + body
+	case drillMenu:
+		legend := m.navStyle.Render("\n" + dietLegend(m.activeDiets) + "  [/] search")
+		searchLine := ""
+		if m.searchInput.Focused() || m.searchInput.Value() != "" {
+			searchLine = "\n" + m.searchInput.View()
+		}
+		return title + "\n" + m.menuList.View() + legend + searchLine
+	case drillDish:
+		return title + "\n" + m.dishViewport.View()
+	}
+	return title
+}
+
+// renderProviderSidebar lists every known provider with a checkbox showing
+// whether the user currently has it enabled, toggleable with the matching
+// number key.
+func (m model) renderProviderSidebar() string {
+	var b strings.Builder
+	b.WriteString("Providers\n")
+	for i, p := range m.providers {
+		box := "[ ]"
+		if m.enabledProviders[p.Name()] {
+			box = "[x]"
+		}
+		fmt.Fprintf(&b, "%s %d:%s\n", box, i+1, p.Name())
+	}
+	return m.sidebarStyle.Render(b.String())
 }
 
 func (m model) renderTerminalInfoView() string {
@@ -215,6 +589,9 @@ func (m model) renderTerminalInfoView() string {
 		Background: %s
 		Color Profile: %s`,
 		m.term, m.width, m.height, m.bg, m.profile)
+	if m.lastColorScheme != "" && m.lastColorScheme != m.profile {
+		info += fmt.Sprintf("\n\t\tPrevious Color Profile: %s", m.lastColorScheme)
+	}
 
 	content := m.txtStyle.Render(info)
 	return title + content
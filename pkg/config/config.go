@@ -0,0 +1,149 @@
+// Package config loads operator-facing server settings: which restaurant
+// providers are enabled, how often menus are refreshed in the background,
+// and the welcome message shown to connecting users.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultProviders is used when ENABLED_PROVIDERS/config.yaml doesn't set
+// any, keeping the server's out-of-the-box behaviour unchanged.
+var defaultProviders = []string{"unicafe"}
+
+const (
+	// defaultDataDir is used when DATA_DIR isn't set.
+	defaultDataDir = "./data"
+
+	// ConfigPath and EnvPath are the files the watch package watches for
+	// changes, so they're exported here as the single source of truth.
+	ConfigPath = "config.yaml"
+	EnvPath    = ".env"
+
+	defaultRefreshInterval = 5 * time.Minute
+	defaultWelcomeMessage  = "Welcome to Unari!"
+)
+
+// Config holds the settings operators can tune without changing code.
+type Config struct {
+	EnabledProviders []string
+	// DataDir is where per-user preference files are written.
+	DataDir string
+	// RefreshInterval is how often the background menu cache refresh runs.
+	RefreshInterval time.Duration
+	// WelcomeMessage is shown to a user when they connect.
+	WelcomeMessage string
+}
+
+// fileConfig mirrors config.yaml's on-disk shape. Every field is optional so
+// operators only need to set what they want to override; an absent field
+// keeps whatever the env-based defaults already produced.
+type fileConfig struct {
+	EnabledProviders []string `yaml:"enabled_providers"`
+	RefreshInterval  string   `yaml:"refresh_interval"`
+	WelcomeMessage   string   `yaml:"welcome_message"`
+}
+
+// LoadValidated reads Config from environment variables and ConfigPath, then
+// validates it, returning an error instead of silently falling back so a bad
+// change (e.g. to config.yaml) can be rejected. Meant to be called after
+// godotenv.Load so .env is picked up too; the watch package also calls this
+// on every hot-reload.
+func LoadValidated() (Config, error) {
+	merged, err := applyFile(ConfigPath, defaults())
+	if err != nil {
+		return Config{}, err
+	}
+	if err := merged.Validate(); err != nil {
+		return Config{}, err
+	}
+	return merged, nil
+}
+
+func defaults() Config {
+	return Config{
+		EnabledProviders: loadEnabledProviders(),
+		DataDir:          loadDataDir(),
+		RefreshInterval:  defaultRefreshInterval,
+		WelcomeMessage:   defaultWelcomeMessage,
+	}
+}
+
+// Validate rejects settings that would break the server if applied, so a
+// hot-reloaded config.yaml can be refused instead of taking effect.
+func (c Config) Validate() error {
+	if len(c.EnabledProviders) == 0 {
+		return fmt.Errorf("config: at least one provider must be enabled")
+	}
+	if c.RefreshInterval <= 0 {
+		return fmt.Errorf("config: refresh_interval must be positive")
+	}
+	if strings.TrimSpace(c.WelcomeMessage) == "" {
+		return fmt.Errorf("config: welcome_message must not be empty")
+	}
+	return nil
+}
+
+// applyFile overlays path's YAML settings onto base, returning base
+// unchanged if path doesn't exist.
+func applyFile(path string, base Config) (Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return base, nil
+	}
+	if err != nil {
+		return base, fmt.Errorf("config: reading %s: %w", path, err)
+	}
+
+	var fc fileConfig
+	if err := yaml.Unmarshal(data, &fc); err != nil {
+		return base, fmt.Errorf("config: parsing %s: %w", path, err)
+	}
+
+	merged := base
+	if len(fc.EnabledProviders) > 0 {
+		merged.EnabledProviders = fc.EnabledProviders
+	}
+	if fc.RefreshInterval != "" {
+		d, err := time.ParseDuration(fc.RefreshInterval)
+		if err != nil {
+			return base, fmt.Errorf("config: invalid refresh_interval %q: %w", fc.RefreshInterval, err)
+		}
+		merged.RefreshInterval = d
+	}
+	if fc.WelcomeMessage != "" {
+		merged.WelcomeMessage = fc.WelcomeMessage
+	}
+	return merged, nil
+}
+
+func loadEnabledProviders() []string {
+	raw := os.Getenv("ENABLED_PROVIDERS")
+	if strings.TrimSpace(raw) == "" {
+		return defaultProviders
+	}
+
+	var providers []string
+	for _, name := range strings.Split(raw, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			providers = append(providers, name)
+		}
+	}
+	if len(providers) == 0 {
+		return defaultProviders
+	}
+	return providers
+}
+
+func loadDataDir() string {
+	if dir := strings.TrimSpace(os.Getenv("DATA_DIR")); dir != "" {
+		return dir
+	}
+	return defaultDataDir
+}
@@ -0,0 +1,43 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// FetchAll concurrently fetches every given provider and merges their
+// restaurants into one slice. A provider that fails doesn't prevent the
+// others' restaurants from coming back or from each other: every provider
+// runs to completion regardless of its siblings' outcome. Each failure is
+// wrapped with its provider's name and joined into the returned error, so a
+// caller that only cares whether everything failed can check len(merged)==0,
+// while one that wants the detail can errors.Is/As or just log the joined
+// error as a warning.
+func FetchAll(ctx context.Context, providers []Provider) ([]Restaurant, error) {
+	results := make([][]Restaurant, len(providers))
+	errs := make([]error, len(providers))
+
+	var wg sync.WaitGroup
+	for i, p := range providers {
+		i, p := i, p
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			restaurants, err := p.Fetch(ctx)
+			if err != nil {
+				errs[i] = fmt.Errorf("fetch: provider %s: %w", p.Name(), err)
+				return
+			}
+			results[i] = restaurants
+		}()
+	}
+	wg.Wait()
+
+	var merged []Restaurant
+	for _, restaurants := range results {
+		merged = append(merged, restaurants...)
+	}
+	return merged, errors.Join(errs...)
+}
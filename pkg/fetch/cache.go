@@ -0,0 +1,50 @@
+package fetch
+
+import (
+	"sync"
+	"time"
+)
+
+// entry holds a cached fetch result alongside the time it was stored, so the
+// cache can tell fresh hits from stale ones that should be revalidated.
+type entry struct {
+	restaurants []Unicafe
+	fetchedAt   time.Time
+}
+
+// ttlCache is a small in-memory cache keyed by day (e.g. "2026-07-29"). It is
+// safe for concurrent use since the background refresh goroutine and the TUI
+// goroutine read and write it independently.
+type ttlCache struct {
+	mu  sync.RWMutex
+	ttl time.Duration
+	m   map[string]entry
+}
+
+func newTTLCache(ttl time.Duration) *ttlCache {
+	return &ttlCache{
+		ttl: ttl,
+		m:   make(map[string]entry),
+	}
+}
+
+// get returns the cached restaurants for day, if any, and whether the entry
+// is still within its TTL. A cache hit is returned even when stale so callers
+// can serve it immediately and trigger a refresh in the background.
+func (c *ttlCache) get(day string) (restaurants []Unicafe, fresh bool, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.m[day]
+	if !ok {
+		return nil, false, false
+	}
+	return e.restaurants, time.Since(e.fetchedAt) < c.ttl, true
+}
+
+func (c *ttlCache) set(day string, restaurants []Unicafe) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.m[day] = entry{restaurants: restaurants, fetchedAt: time.Now()}
+}
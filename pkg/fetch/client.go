@@ -0,0 +1,106 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultTimeout bounds how long a single HTTP round trip to an upstream
+	// restaurant API is allowed to take before Client gives up on it.
+	DefaultTimeout  = 10 * time.Second
+	defaultCacheTTL = 15 * time.Minute
+)
+
+// Client fetches restaurant menus over HTTP, serving cached results when
+// available and refreshing them in the background so callers never block on
+// the network once the cache has been warmed.
+type Client struct {
+	httpClient *http.Client
+	cache      *ttlCache
+}
+
+func NewClient(timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Client{
+		httpClient: &http.Client{Timeout: timeout},
+		cache:      newTTLCache(defaultCacheTTL),
+	}
+}
+
+// Fetch returns the restaurant menus cached for day. If the cached entry has
+// gone stale it is still returned immediately (stale-while-revalidate) and a
+// background refresh is kicked off so the next call sees fresh data. On a
+// cold cache it blocks on a single upstream request.
+func (c *Client) Fetch(ctx context.Context, day string) (restaurants []Unicafe, stale bool, err error) {
+	if cached, fresh, ok := c.cache.get(day); ok {
+		if !fresh {
+			go c.Refresh(context.Background(), day)
+		}
+		return cached, !fresh, nil
+	}
+
+	restaurants, err = c.fetchAndStore(ctx, day)
+	return restaurants, false, err
+}
+
+// Refresh force-fetches day and replaces whatever is cached for it.
+func (c *Client) Refresh(ctx context.Context, day string) error {
+	_, err := c.fetchAndStore(ctx, day)
+	return err
+}
+
+// RefreshAll concurrently refreshes every entry in days using an errgroup, so
+// warming several days of cache costs one round trip's worth of latency
+// instead of len(days) round trips.
+func (c *Client) RefreshAll(ctx context.Context, days []string) error {
+	g, gctx := errgroup.WithContext(ctx)
+	for _, day := range days {
+		day := day
+		g.Go(func() error {
+			return c.Refresh(gctx, day)
+		})
+	}
+	return g.Wait()
+}
+
+// StartDynamicBackgroundRefresh refreshes day on every tick until ctx is
+// cancelled, keeping the cache warm independent of whether anyone is looking
+// at the TUI. interval is called fresh after every tick rather than fixed up
+// front, so a hot-reloaded config can change the refresh cadence without
+// restarting the server.
+func (c *Client) StartDynamicBackgroundRefresh(ctx context.Context, day string, interval func() time.Duration) {
+	go func() {
+		timer := time.NewTimer(interval())
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				_ = c.Refresh(ctx, day)
+				timer.Reset(interval())
+			}
+		}
+	}()
+}
+
+// today returns the cache key for "right now", in the same format the
+// Unicafe API uses for its menu dates.
+func today() string {
+	return time.Now().Format("2006-01-02")
+}
+
+func (c *Client) fetchAndStore(ctx context.Context, day string) ([]Unicafe, error) {
+	restaurants, err := fetchUnicafe(ctx, c.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(day, restaurants)
+	return restaurants, nil
+}
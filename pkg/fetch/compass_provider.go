@@ -0,0 +1,22 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+)
+
+func init() {
+	RegisterProvider("compass", func() Provider { return CompassProvider{} })
+}
+
+// CompassProvider will adapt Compass Group's restaurant API once we have
+// access to document its JSON shape. It's registered now so operators can
+// already list it in ENABLED_PROVIDERS ahead of the real implementation
+// landing.
+type CompassProvider struct{}
+
+func (CompassProvider) Name() string { return "compass" }
+
+func (CompassProvider) Fetch(ctx context.Context) ([]Restaurant, error) {
+	return nil, fmt.Errorf("fetch: compass provider not implemented yet")
+}
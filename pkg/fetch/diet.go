@@ -0,0 +1,63 @@
+package fetch
+
+import "strings"
+
+// Diet is a Finnish student-restaurant dietary marker, e.g. "G" for
+// gluten-free or "VE" for vegan, as printed alongside dish ingredients.
+type Diet string
+
+const (
+	DietVegan       Diet = "VE"
+	DietVegetarian  Diet = "VEG"
+	DietLactoseFree Diet = "L"
+	DietLowLactose  Diet = "VL"
+	DietGlutenFree  Diet = "G"
+	DietMilkFree    Diet = "M"
+	DietSoy         Diet = "VS"
+)
+
+// knownDiets lists every marker ParseDiets recognizes, in the order they
+// should be displayed.
+var knownDiets = []Diet{
+	DietVegan,
+	DietVegetarian,
+	DietLactoseFree,
+	DietLowLactose,
+	DietGlutenFree,
+	DietMilkFree,
+	DietSoy,
+}
+
+// ParseDiets scans ingredients for the dietary markers Unicafe appends after
+// a dish name (e.g. "Kasvispyörykät, perunamuusi (L, G, VEG)") and returns the
+// ones it recognizes, in knownDiets order with duplicates removed. Unknown
+// tokens are ignored rather than treated as an error, since upstream is free
+// to introduce new markers at any time.
+func ParseDiets(ingredients string) []Diet {
+	tokens := strings.FieldsFunc(ingredients, func(r rune) bool {
+		switch r {
+		case ',', '(', ')', ' ', '\n', '\t':
+			return true
+		default:
+			return false
+		}
+	})
+
+	found := make(map[Diet]bool, len(tokens))
+	for _, t := range tokens {
+		found[Diet(strings.TrimSpace(t))] = true
+	}
+
+	diets := make([]Diet, 0, len(found))
+	for _, known := range knownDiets {
+		if found[known] {
+			diets = append(diets, known)
+		}
+	}
+	return diets
+}
+
+// Diets returns the dietary markers parsed out of d's ingredient list.
+func (d Data) Diets() []Diet {
+	return ParseDiets(d.Ingredients)
+}
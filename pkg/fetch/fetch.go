@@ -1,7 +1,9 @@
 package fetch
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 )
@@ -38,13 +40,6 @@ type MenuData struct {
 	Areacode        uint          `json:"areacode"`
 }
 
-type VisitingHours struct {
-	Business  any `json:"business"`  // TODO: any type
-	Breakfast any `json:"breakfast"` // TODO: any type
-	Bistro    any `json:"bistro"`    // TODO: any type
-	Lunch     any `json:"lounas"`    // TODO: any type
-}
-
 type Menu struct {
 	Date    string `json:"date"`
 	Message string `json:"message"`
@@ -59,24 +54,30 @@ type Data struct {
 	// Meta        any    `json:"meta"`
 }
 
-type Price struct {
-	Value any    `json:"value"` // TODO: any type
-	Name  string `json:"name"`
-}
+// fetchUnicafe performs the actual HTTP round trip against UNICAFE_API using
+// the given http.Client, so callers can control timeouts and transport
+// behaviour. It is unexported because all consumers should go through
+// Client, which adds caching and concurrency on top.
+func fetchUnicafe(ctx context.Context, httpClient *http.Client) ([]Unicafe, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, UNICAFE_API, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: building request: %w", err)
+	}
 
-func GetUnicafe() ([]Unicafe, error) {
-	resp, err := http.Get(UNICAFE_API)
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		// TODO: better error message
-		return nil, err
+		return nil, fmt.Errorf("fetch: requesting unicafe: %w", err)
 	}
-	body, err := io.ReadAll(resp.Body)
+	defer resp.Body.Close()
 
-	var data []Unicafe
-	err = json.Unmarshal(body, &data)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		// TODO: better error message
-		return nil, err
+		return nil, fmt.Errorf("fetch: reading unicafe response: %w", err)
+	}
+
+	var restaurants []Unicafe
+	if err := json.Unmarshal(body, &restaurants); err != nil {
+		return nil, fmt.Errorf("fetch: decoding unicafe response: %w", err)
 	}
-	return data, nil
+	return restaurants, nil
 }
@@ -0,0 +1,91 @@
+package fetch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// VisitingHours lists a restaurant's opening hours per service, as reported
+// by the menuData endpoint.
+type VisitingHours struct {
+	Business  HoursEntry `json:"business"`
+	Breakfast HoursEntry `json:"breakfast"`
+	Bistro    HoursEntry `json:"bistro"`
+	Lunch     HoursEntry `json:"lounas"`
+}
+
+// HoursEntry is a single opening-hours entry. Upstream encodes it as one of:
+// the bool false (closed all day), a "10:00-14:00" string, a two-element
+// array of [open, close], or an object with "open"/"close"/"closed" keys.
+// UnmarshalJSON discriminates on the JSON token and normalizes all of them
+// into this shape.
+type HoursEntry struct {
+	Open   string
+	Close  string
+	Closed bool
+}
+
+func (h *HoursEntry) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || string(trimmed) == "null" {
+		*h = HoursEntry{Closed: true}
+		return nil
+	}
+
+	switch trimmed[0] {
+	case 't', 'f':
+		var open bool
+		if err := json.Unmarshal(trimmed, &open); err != nil {
+			return fmt.Errorf("hours: decoding bool: %w", err)
+		}
+		*h = HoursEntry{Closed: !open}
+		return nil
+
+	case '"':
+		var r string
+		if err := json.Unmarshal(trimmed, &r); err != nil {
+			return fmt.Errorf("hours: decoding string: %w", err)
+		}
+		open, closeTime, ok := splitHoursRange(r)
+		if !ok {
+			return fmt.Errorf("hours: unrecognized range %q", r)
+		}
+		*h = HoursEntry{Open: open, Close: closeTime}
+		return nil
+
+	case '[':
+		var pair [2]string
+		if err := json.Unmarshal(trimmed, &pair); err != nil {
+			return fmt.Errorf("hours: decoding array: %w", err)
+		}
+		*h = HoursEntry{Open: pair[0], Close: pair[1]}
+		return nil
+
+	case '{':
+		var obj struct {
+			Open   string `json:"open"`
+			Close  string `json:"close"`
+			Closed bool   `json:"closed"`
+		}
+		if err := json.Unmarshal(trimmed, &obj); err != nil {
+			return fmt.Errorf("hours: decoding object: %w", err)
+		}
+		*h = HoursEntry(obj)
+		return nil
+
+	default:
+		return fmt.Errorf("hours: unexpected token %q", trimmed[:1])
+	}
+}
+
+// splitHoursRange splits an "open-close" string such as "10:00-14:00" into
+// its two halves.
+func splitHoursRange(r string) (open, closeTime string, ok bool) {
+	parts := strings.SplitN(r, "-", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), true
+}
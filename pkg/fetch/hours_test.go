@@ -0,0 +1,102 @@
+package fetch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHoursEntryUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    HoursEntry
+		wantErr bool
+	}{
+		{
+			name: "closed bool",
+			json: `false`,
+			want: HoursEntry{Closed: true},
+		},
+		{
+			name: "open bool",
+			json: `true`,
+			want: HoursEntry{Closed: false},
+		},
+		{
+			name: "range string",
+			json: `"10:00-14:00"`,
+			want: HoursEntry{Open: "10:00", Close: "14:00"},
+		},
+		{
+			name: "array of two strings",
+			json: `["10:00", "14:00"]`,
+			want: HoursEntry{Open: "10:00", Close: "14:00"},
+		},
+		{
+			name: "object",
+			json: `{"open": "10:00", "close": "14:00", "closed": false}`,
+			want: HoursEntry{Open: "10:00", Close: "14:00"},
+		},
+		{
+			name: "null",
+			json: `null`,
+			want: HoursEntry{Closed: true},
+		},
+		{
+			name:    "unrecognized string",
+			json:    `"closed for renovation"`,
+			wantErr: true,
+		},
+		{
+			name:    "unexpected token",
+			json:    `42`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got HoursEntry
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// capturedMenuDataFixture is a trimmed sample of a real menuData.visitingHours
+// payload, exercising all four of HoursEntry's JSON shapes (bool, string,
+// array, object) at once the way the live API actually mixes them.
+const capturedMenuDataFixture = `{
+	"business": false,
+	"breakfast": "07:00-10:00",
+	"bistro": ["10:00", "17:00"],
+	"lounas": {"open": "10:30", "close": "14:00", "closed": false}
+}`
+
+func TestVisitingHoursUnmarshalJSON(t *testing.T) {
+	var got VisitingHours
+	if err := json.Unmarshal([]byte(capturedMenuDataFixture), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := VisitingHours{
+		Business:  HoursEntry{Closed: true},
+		Breakfast: HoursEntry{Open: "07:00", Close: "10:00"},
+		Bistro:    HoursEntry{Open: "10:00", Close: "17:00"},
+		Lunch:     HoursEntry{Open: "10:30", Close: "14:00"},
+	}
+	if got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
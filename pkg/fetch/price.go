@@ -0,0 +1,71 @@
+package fetch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Price is a priced menu item's display name alongside its normalized value.
+type Price struct {
+	Value PriceValue `json:"value"`
+	Name  string     `json:"name"`
+}
+
+// PriceValue is a dish's price, broken down by customer group. Upstream
+// encodes it as the bool false (no price set), a single numeric string
+// (the same price for everyone), or an object with per-group keys.
+// UnmarshalJSON discriminates on the JSON token and normalizes all of them
+// into this shape.
+type PriceValue struct {
+	Student float64
+	Staff   float64
+	Regular float64
+	Unset   bool
+}
+
+func (p *PriceValue) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || string(trimmed) == "null" {
+		*p = PriceValue{Unset: true}
+		return nil
+	}
+
+	switch trimmed[0] {
+	case 't', 'f':
+		var set bool
+		if err := json.Unmarshal(trimmed, &set); err != nil {
+			return fmt.Errorf("price: decoding bool: %w", err)
+		}
+		*p = PriceValue{Unset: !set}
+		return nil
+
+	case '"':
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return fmt.Errorf("price: decoding string: %w", err)
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("price: parsing %q as a number: %w", s, err)
+		}
+		*p = PriceValue{Student: v, Staff: v, Regular: v}
+		return nil
+
+	case '{':
+		var obj struct {
+			Student float64 `json:"student"`
+			Staff   float64 `json:"staff"`
+			Regular float64 `json:"regular"`
+		}
+		if err := json.Unmarshal(trimmed, &obj); err != nil {
+			return fmt.Errorf("price: decoding object: %w", err)
+		}
+		*p = PriceValue{Student: obj.Student, Staff: obj.Staff, Regular: obj.Regular}
+		return nil
+
+	default:
+		return fmt.Errorf("price: unexpected token %q", trimmed[:1])
+	}
+}
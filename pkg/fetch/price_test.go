@@ -0,0 +1,85 @@
+package fetch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPriceValueUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    PriceValue
+		wantErr bool
+	}{
+		{
+			name: "unset bool",
+			json: `false`,
+			want: PriceValue{Unset: true},
+		},
+		{
+			name: "numeric string",
+			json: `"3.10"`,
+			want: PriceValue{Student: 3.10, Staff: 3.10, Regular: 3.10},
+		},
+		{
+			name: "object",
+			json: `{"student": 3.10, "staff": 5.90, "regular": 7.30}`,
+			want: PriceValue{Student: 3.10, Staff: 5.90, Regular: 7.30},
+		},
+		{
+			name: "null",
+			json: `null`,
+			want: PriceValue{Unset: true},
+		},
+		{
+			name:    "non-numeric string",
+			json:    `"ask staff"`,
+			wantErr: true,
+		},
+		{
+			name:    "unexpected token",
+			json:    `[1, 2]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got PriceValue
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// capturedPriceFixture is a trimmed sample of a real menu item, pairing a
+// priced Price.Value with the surrounding Name field so the object-shaped
+// decode path is tested through the type that's actually embedded in Data.
+const capturedPriceFixture = `{"value": {"student": 3.10, "staff": 5.90, "regular": 7.30}, "name": "Lounaslista"}`
+
+func TestPriceUnmarshalJSON(t *testing.T) {
+	var got Price
+	if err := json.Unmarshal([]byte(capturedPriceFixture), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := Price{
+		Value: PriceValue{Student: 3.10, Staff: 5.90, Regular: 7.30},
+		Name:  "Lounaslista",
+	}
+	if got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+package fetch
+
+import "context"
+
+// Provider is a student-restaurant backend the TUI can pull menus from. Each
+// provider normalizes its own upstream JSON shape into Restaurant so the TUI
+// never has to know which backend a given restaurant came from.
+type Provider interface {
+	// Name identifies the provider for config toggles and UI labels, e.g.
+	// "unicafe".
+	Name() string
+	// Fetch returns every restaurant the provider currently knows about.
+	Fetch(ctx context.Context) ([]Restaurant, error)
+}
+
+// Restaurant is a restaurant normalized across every Provider.
+type Restaurant struct {
+	Provider string
+	Name     string
+	Campus   string
+	Address  string
+	Menus    []RestaurantMenu
+	// Stale is true when this Restaurant's menus came from a cache entry
+	// that's past its TTL and is being refreshed in the background, so the
+	// TUI can tell the user they're looking at slightly old data.
+	Stale bool
+}
+
+// RestaurantMenu is one day's menu at a Restaurant.
+type RestaurantMenu struct {
+	Date   string
+	Dishes []Dish
+}
+
+// Dish is a single normalized menu item.
+type Dish struct {
+	Name        string
+	Ingredients string
+	Nutrition   string
+	Diets       []Diet
+	Price       PriceValue
+}
+
+// ProviderFactory constructs a Provider with sane defaults. Providers that
+// need shared state (e.g. the Unicafe provider's cached Client) are
+// constructed explicitly by the caller instead of going through the
+// registry.
+type ProviderFactory func() Provider
+
+var providerFactories = map[string]ProviderFactory{}
+
+// RegisterProvider makes a provider constructable by name through
+// NewEnabledProviders. It is meant to be called from a provider's package
+// init so operators can enable it purely via config, without main needing to
+// know the concrete type.
+func RegisterProvider(name string, factory ProviderFactory) {
+	providerFactories[name] = factory
+}
+
+// NewEnabledProviders constructs one Provider per name that has a registered
+// factory, silently skipping names that don't (e.g. "unicafe", which is
+// constructed separately since it needs a shared Client).
+func NewEnabledProviders(names []string) []Provider {
+	providers := make([]Provider, 0, len(names))
+	for _, name := range names {
+		if factory, ok := providerFactories[name]; ok {
+			providers = append(providers, factory())
+		}
+	}
+	return providers
+}
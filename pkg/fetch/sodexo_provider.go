@@ -0,0 +1,21 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+)
+
+func init() {
+	RegisterProvider("sodexo", func() Provider { return SodexoProvider{} })
+}
+
+// SodexoProvider will adapt Sodexo's restaurant API once we have access to
+// document its JSON shape. It's registered now so operators can already list
+// it in ENABLED_PROVIDERS ahead of the real implementation landing.
+type SodexoProvider struct{}
+
+func (SodexoProvider) Name() string { return "sodexo" }
+
+func (SodexoProvider) Fetch(ctx context.Context) ([]Restaurant, error) {
+	return nil, fmt.Errorf("fetch: sodexo provider not implemented yet")
+}
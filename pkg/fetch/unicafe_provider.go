@@ -0,0 +1,69 @@
+package fetch
+
+import "context"
+
+// UnicafeProvider adapts Client, and the Unicafe-specific JSON shape it
+// decodes, to the Provider interface. It isn't registered through
+// RegisterProvider because it needs a shared Client (for the TTL cache and
+// background refresh) rather than being constructed fresh on demand.
+type UnicafeProvider struct {
+	client *Client
+}
+
+func NewUnicafeProvider(client *Client) *UnicafeProvider {
+	return &UnicafeProvider{client: client}
+}
+
+func (p *UnicafeProvider) Name() string { return "unicafe" }
+
+func (p *UnicafeProvider) Fetch(ctx context.Context) ([]Restaurant, error) {
+	restaurants, stale, err := p.client.Fetch(ctx, today())
+	if err != nil {
+		return nil, err
+	}
+	return normalizeUnicafe(restaurants, stale), nil
+}
+
+func normalizeUnicafe(restaurants []Unicafe, stale bool) []Restaurant {
+	out := make([]Restaurant, len(restaurants))
+	for i, r := range restaurants {
+		out[i] = Restaurant{
+			Provider: "unicafe",
+			Name:     r.Title,
+			Campus:   campusFromLocations(r.Location),
+			Address:  r.Address,
+			Menus:    normalizeMenus(r.Menu.Menus),
+			Stale:    stale,
+		}
+	}
+	return out
+}
+
+func normalizeMenus(menus []Menu) []RestaurantMenu {
+	out := make([]RestaurantMenu, len(menus))
+	for i, menu := range menus {
+		out[i] = RestaurantMenu{Date: menu.Date, Dishes: normalizeDishes(menu.Data)}
+	}
+	return out
+}
+
+func normalizeDishes(data []Data) []Dish {
+	out := make([]Dish, len(data))
+	for i, d := range data {
+		out[i] = Dish{
+			Name:        d.Name,
+			Ingredients: d.Ingredients,
+			Nutrition:   d.Nutrition,
+			Diets:       d.Diets(),
+			Price:       d.Price.Value,
+		}
+	}
+	return out
+}
+
+func campusFromLocations(locations []Location) string {
+	if len(locations) == 0 {
+		return ""
+	}
+	return locations[0].Name
+}
@@ -0,0 +1,21 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+)
+
+func init() {
+	RegisterProvider("uniresta", func() Provider { return UniRestaProvider{} })
+}
+
+// UniRestaProvider will adapt UniResta's restaurant API once we have access
+// to document its JSON shape. It's registered now so operators can already
+// list it in ENABLED_PROVIDERS ahead of the real implementation landing.
+type UniRestaProvider struct{}
+
+func (UniRestaProvider) Name() string { return "uniresta" }
+
+func (UniRestaProvider) Fetch(ctx context.Context) ([]Restaurant, error) {
+	return nil, fmt.Errorf("fetch: uniresta provider not implemented yet")
+}
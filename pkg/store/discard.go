@@ -0,0 +1,11 @@
+package store
+
+// Discard is a Store that never persists anything. It's used when there's
+// no stable identity to key preferences on, e.g. a session that didn't
+// authenticate with a public key.
+var Discard Store = discardStore{}
+
+type discardStore struct{}
+
+func (discardStore) Load(string) (Preferences, error) { return Preferences{}, nil }
+func (discardStore) Save(string, Preferences) error   { return nil }
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// FileStore persists one JSON file per user under Dir.
+type FileStore struct {
+	Dir string
+
+	mu sync.Mutex
+}
+
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{Dir: dir}
+}
+
+// fileSanitizer strips characters SSH key fingerprints contain (e.g.
+// "SHA256:abc/def+==") that aren't safe in a filename.
+var fileSanitizer = strings.NewReplacer("/", "_", "+", "-", ":", "_", "=", "")
+
+func (s *FileStore) path(key string) string {
+	return filepath.Join(s.Dir, fileSanitizer.Replace(key)+".json")
+}
+
+func (s *FileStore) Load(key string) (Preferences, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return Preferences{}, nil
+	}
+	if err != nil {
+		return Preferences{}, fmt.Errorf("store: reading preferences: %w", err)
+	}
+
+	var prefs Preferences
+	if err := json.Unmarshal(data, &prefs); err != nil {
+		return Preferences{}, fmt.Errorf("store: decoding preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func (s *FileStore) Save(key string, prefs Preferences) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return fmt.Errorf("store: creating data dir: %w", err)
+	}
+
+	data, err := json.MarshalIndent(prefs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("store: encoding preferences: %w", err)
+	}
+
+	// Write to a temp file and rename into place so a crash mid-write can't
+	// leave a truncated preferences file behind.
+	dst := s.path(key)
+	tmp := dst + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("store: writing preferences: %w", err)
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return fmt.Errorf("store: finalizing preferences: %w", err)
+	}
+	return nil
+}
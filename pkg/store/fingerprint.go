@@ -0,0 +1,9 @@
+package store
+
+import "golang.org/x/crypto/ssh"
+
+// Fingerprint returns a stable identifier for a public key, suitable as a
+// Store key across reconnects.
+func Fingerprint(key ssh.PublicKey) string {
+	return ssh.FingerprintSHA256(key)
+}
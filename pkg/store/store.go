@@ -0,0 +1,26 @@
+// Package store persists per-user preferences across SSH sessions, keyed by
+// a stable identifier such as a public key fingerprint.
+package store
+
+// Preferences is the per-user state that survives across SSH sessions.
+type Preferences struct {
+	FavoriteRestaurants []string `json:"favoriteRestaurants"`
+	PreferredCampus     string   `json:"preferredCampus"`
+	DietaryFilters      []string `json:"dietaryFilters"`
+	LastView            string   `json:"lastView"`
+	// LastViewedDate is the YYYY-MM-DD the user was last seen on, so a
+	// returning session can tell them how long it's been.
+	LastViewedDate string `json:"lastViewedDate"`
+	// ColorScheme is the termenv color profile name the last session
+	// rendered with (e.g. "TrueColor"), shown back for comparison against
+	// the current session's detected profile.
+	ColorScheme string `json:"colorScheme"`
+}
+
+// Store loads and saves Preferences keyed by a stable per-user identifier.
+// Load must return a zero-value Preferences, not an error, when no record
+// exists yet for key.
+type Store interface {
+	Load(key string) (Preferences, error)
+	Save(key string, prefs Preferences) error
+}
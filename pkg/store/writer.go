@@ -0,0 +1,62 @@
+package store
+
+import (
+	"sync"
+	"time"
+)
+
+// Writer debounces Preferences saves to an underlying Store, coalescing
+// rapid changes (e.g. one per keystroke while filtering) into a single
+// write after delay has passed without another change.
+type Writer struct {
+	store Store
+	key   string
+	delay time.Duration
+
+	// OnError, if set, is called whenever a debounced save fails.
+	OnError func(error)
+
+	mu      sync.Mutex
+	pending Preferences
+	dirty   bool
+	timer   *time.Timer
+}
+
+func NewWriter(s Store, key string, delay time.Duration) *Writer {
+	return &Writer{store: s, key: key, delay: delay}
+}
+
+// Set schedules prefs to be saved after the debounce delay, replacing any
+// write already pending.
+func (w *Writer) Set(prefs Preferences) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending = prefs
+	w.dirty = true
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	w.timer = time.AfterFunc(w.delay, w.flush)
+}
+
+// Flush writes any pending change immediately, ignoring the debounce delay.
+// Call it when a session ends so its last changes aren't lost.
+func (w *Writer) Flush() {
+	w.flush()
+}
+
+func (w *Writer) flush() {
+	w.mu.Lock()
+	if !w.dirty {
+		w.mu.Unlock()
+		return
+	}
+	prefs := w.pending
+	w.dirty = false
+	w.mu.Unlock()
+
+	if err := w.store.Save(w.key, prefs); err != nil && w.OnError != nil {
+		w.OnError(err)
+	}
+}
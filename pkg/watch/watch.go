@@ -0,0 +1,174 @@
+// Package watch hot-reloads operator-facing configuration from disk — the
+// .env file, config.yaml and the SSH host key — so the server doesn't need
+// restarting to pick up new settings.
+package watch
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	config "github.com/eeritvan/unari-ssh/pkg/config"
+
+	"github.com/charmbracelet/log"
+	"github.com/fsnotify/fsnotify"
+	"github.com/joho/godotenv"
+)
+
+// ConfigMsg is delivered to every subscribed Bubble Tea session when the
+// config is reloaded, so open UIs can pick up a new welcome message or
+// refresh interval without reconnecting.
+type ConfigMsg struct {
+	Config config.Config
+}
+
+// Watcher keeps a validated Config up to date by watching config.ConfigPath
+// and config.EnvPath for changes, swapping it in atomically so readers never
+// observe a half-applied reload. It also watches hostKeyPath, though live
+// host key rotation isn't something the underlying SSH server supports, so
+// that change is only logged as requiring a restart.
+type Watcher struct {
+	current atomic.Pointer[config.Config]
+
+	hostKeyPath string
+
+	mu          sync.Mutex
+	subscribers map[int]chan config.Config
+	nextID      int
+}
+
+// New loads the initial config and returns a Watcher ready to have Start
+// called on it.
+func New(hostKeyPath string) (*Watcher, error) {
+	cfg, err := config.LoadValidated()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		hostKeyPath: hostKeyPath,
+		subscribers: map[int]chan config.Config{},
+	}
+	w.current.Store(&cfg)
+	return w, nil
+}
+
+// Current returns the most recently validated Config.
+func (w *Watcher) Current() config.Config {
+	return *w.current.Load()
+}
+
+// Subscribe registers a channel that receives every successfully reloaded
+// Config. Callers must call the returned cancel func once they're done (e.g.
+// when their SSH session ends) so the Watcher stops trying to send to it.
+func (w *Watcher) Subscribe() (<-chan config.Config, func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	id := w.nextID
+	w.nextID++
+	ch := make(chan config.Config, 1)
+	w.subscribers[id] = ch
+
+	cancel := func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if ch, ok := w.subscribers[id]; ok {
+			delete(w.subscribers, id)
+			close(ch)
+		}
+	}
+	return ch, cancel
+}
+
+// Start watches config.ConfigPath, config.EnvPath and hostKeyPath for
+// changes until ctx is cancelled, reloading and broadcasting the config
+// whenever one of the first two changes.
+func (w *Watcher) Start(ctx context.Context) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	dirs := map[string]bool{}
+	for _, path := range []string{config.ConfigPath, config.EnvPath, w.hostKeyPath} {
+		dirs[filepath.Dir(path)] = true
+	}
+	watching := 0
+	for dir := range dirs {
+		if err := fsw.Add(dir); err != nil {
+			// One unwatchable directory (e.g. .ssh missing) shouldn't stop
+			// the others from being watched.
+			log.Error("Could not watch directory for config changes", "dir", dir, "error", err)
+			continue
+		}
+		watching++
+	}
+	if watching == 0 {
+		fsw.Close()
+		return errors.New("watch: no directories could be watched")
+	}
+
+	go func() {
+		defer fsw.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-fsw.Events:
+				if !ok {
+					return
+				}
+				w.handleEvent(event)
+			case err, ok := <-fsw.Errors:
+				if !ok {
+					return
+				}
+				log.Error("Config watcher error", "error", err)
+			}
+		}
+	}()
+	return nil
+}
+
+func (w *Watcher) handleEvent(event fsnotify.Event) {
+	if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+		return
+	}
+
+	switch filepath.Clean(event.Name) {
+	case filepath.Clean(w.hostKeyPath):
+		log.Info("Host key file changed on disk; restart the server to use the new key")
+	case filepath.Clean(config.ConfigPath), filepath.Clean(config.EnvPath):
+		w.reload()
+	}
+}
+
+func (w *Watcher) reload() {
+	// Overload refreshes process env vars from .env so a changed
+	// ENABLED_PROVIDERS or DATA_DIR is visible to config.LoadValidated below;
+	// Load/Overload ignore a missing file, which matches .env being optional.
+	_ = godotenv.Overload(config.EnvPath)
+
+	cfg, err := config.LoadValidated()
+	if err != nil {
+		log.Error("Rejected invalid config reload, keeping previous config", "error", err)
+		return
+	}
+
+	w.current.Store(&cfg)
+	log.Info("Reloaded config", "providers", cfg.EnabledProviders, "refreshInterval", cfg.RefreshInterval)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, ch := range w.subscribers {
+		select {
+		case ch <- cfg:
+		default:
+			// Subscriber hasn't drained the last update yet; drop rather
+			// than block the watcher goroutine.
+		}
+	}
+}
@@ -0,0 +1,469 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	fetch "github.com/eeritvan/unari-ssh/pkg/fetch"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sahilm/fuzzy"
+)
+
+// drillState tracks how far the user has drilled into restaurantView:
+// restaurant list -> that restaurant's daily menu -> a single dish's detail.
+type drillState int
+
+const (
+	drillRestaurants drillState = iota
+	drillMenu
+	drillDish
+)
+
+// restaurantItem adapts fetch.Restaurant to list.Item for the top-level
+// list.
+type restaurantItem struct {
+	restaurant fetch.Restaurant
+	favorite   bool
+}
+
+func (i restaurantItem) Title() string {
+	if i.favorite {
+		return "★ " + i.restaurant.Name
+	}
+	return i.restaurant.Name
+}
+
+func (i restaurantItem) Description() string {
+	if i.restaurant.Campus == "" {
+		return i.restaurant.Address
+	}
+	return fmt.Sprintf("%s · %s", i.restaurant.Campus, i.restaurant.Address)
+}
+
+func (i restaurantItem) FilterValue() string { return i.restaurant.Name }
+
+// dishItem adapts fetch.Dish to list.Item for the daily menu list.
+type dishItem struct {
+	dish fetch.Dish
+}
+
+func (i dishItem) Title() string { return i.dish.Name }
+
+func (i dishItem) Description() string {
+	if len(i.dish.Diets) == 0 {
+		return ""
+	}
+	labels := make([]string, len(i.dish.Diets))
+	for idx, d := range i.dish.Diets {
+		labels[idx] = string(d)
+	}
+	return strings.Join(labels, ", ")
+}
+
+func (i dishItem) FilterValue() string { return i.dish.Name }
+
+func restaurantListItems(restaurants []fetch.Restaurant, favorites map[string]bool) []list.Item {
+	items := make([]list.Item, len(restaurants))
+	for i, r := range restaurants {
+		items[i] = restaurantItem{restaurant: r, favorite: favorites[r.Name]}
+	}
+	return items
+}
+
+func dishListItems(dishes []fetch.Dish) []list.Item {
+	items := make([]list.Item, len(dishes))
+	for i, d := range dishes {
+		items[i] = dishItem{dish: d}
+	}
+	return items
+}
+
+// mergeByCampus groups restaurants from every enabled provider by campus so
+// the list reads as one merged directory rather than provider-by-provider
+// chunks. Favorites sort first, then the preferred campus, then campus and
+// name alphabetically.
+func mergeByCampus(restaurants []fetch.Restaurant, preferredCampus string, favorites map[string]bool) []fetch.Restaurant {
+	merged := make([]fetch.Restaurant, len(restaurants))
+	copy(merged, restaurants)
+	sort.SliceStable(merged, func(i, j int) bool {
+		fi, fj := favorites[merged[i].Name], favorites[merged[j].Name]
+		if fi != fj {
+			return fi
+		}
+		pi, pj := merged[i].Campus == preferredCampus, merged[j].Campus == preferredCampus
+		if pi != pj {
+			return pi
+		}
+		if merged[i].Campus != merged[j].Campus {
+			return merged[i].Campus < merged[j].Campus
+		}
+		return merged[i].Name < merged[j].Name
+	})
+	return merged
+}
+
+// favoritesFromNames and favoriteNames convert the favorite restaurant set
+// between the map used for O(1) lookups in the model and the string slice
+// Preferences stores it as.
+func favoritesFromNames(names []string) map[string]bool {
+	favorites := make(map[string]bool, len(names))
+	for _, name := range names {
+		favorites[name] = true
+	}
+	return favorites
+}
+
+func favoriteNames(favorites map[string]bool) []string {
+	names := make([]string, 0, len(favorites))
+	for name := range favorites {
+		names = append(names, name)
+	}
+	return names
+}
+
+// dietsFromNames and dietNames convert the active diet filter set between
+// the map used in the model and the string slice Preferences stores it as.
+func dietsFromNames(names []string) map[fetch.Diet]bool {
+	active := make(map[fetch.Diet]bool, len(names))
+	for _, name := range names {
+		active[fetch.Diet(name)] = true
+	}
+	return active
+}
+
+func dietNames(active map[fetch.Diet]bool) []string {
+	names := make([]string, 0, len(active))
+	for d := range active {
+		names = append(names, string(d))
+	}
+	return names
+}
+
+// todaysDishes returns the dishes of restaurant's menu for day, or nil if
+// that day isn't in the fetched data.
+func todaysDishes(restaurant fetch.Restaurant, day string) []fetch.Dish {
+	for _, menu := range restaurant.Menus {
+		if menu.Date == day {
+			return menu.Dishes
+		}
+	}
+	return nil
+}
+
+// filterByDiets keeps only the dishes that carry every diet in active. An
+// empty active set matches everything.
+func filterByDiets(dishes []fetch.Dish, active map[fetch.Diet]bool) []fetch.Dish {
+	if len(active) == 0 {
+		return dishes
+	}
+
+	filtered := make([]fetch.Dish, 0, len(dishes))
+	for _, dish := range dishes {
+		matchesAll := true
+		for want := range active {
+			found := false
+			for _, have := range dish.Diets {
+				if have == want {
+					found = true
+					break
+				}
+			}
+			if !found {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			filtered = append(filtered, dish)
+		}
+	}
+	return filtered
+}
+
+// fuzzyFilterDishes narrows dishes down to the ones whose name fuzzy-matches
+// query, ranked by sahilm/fuzzy's match score. An empty query matches
+// everything.
+func fuzzyFilterDishes(dishes []fetch.Dish, query string) []fetch.Dish {
+	if query == "" {
+		return dishes
+	}
+
+	names := make([]string, len(dishes))
+	for i, d := range dishes {
+		names[i] = d.Name
+	}
+
+	matches := fuzzy.Find(query, names)
+	filtered := make([]fetch.Dish, len(matches))
+	for i, match := range matches {
+		filtered[i] = dishes[match.Index]
+	}
+	return filtered
+}
+
+// dietKeys maps the number keys 1-7 to the markers in knownDiets order, so
+// the menu view can show "[1] VE  [2] VEG  ..." as a checkbox-style legend.
+var dietKeys = []fetch.Diet{
+	fetch.DietVegan,
+	fetch.DietVegetarian,
+	fetch.DietLactoseFree,
+	fetch.DietLowLactose,
+	fetch.DietGlutenFree,
+	fetch.DietMilkFree,
+	fetch.DietSoy,
+}
+
+// providerForKey maps the number keys 1-9 to providers in the order they're
+// listed in the sidebar, so digits toggle a provider on/off while browsing
+// the restaurant list.
+func providerForKey(providers []fetch.Provider, key string) (string, bool) {
+	for i, p := range providers {
+		if key == fmt.Sprintf("%d", i+1) {
+			return p.Name(), true
+		}
+	}
+	return "", false
+}
+
+func dietForKey(key string) (fetch.Diet, bool) {
+	for i, d := range dietKeys {
+		if key == fmt.Sprintf("%d", i+1) {
+			return d, true
+		}
+	}
+	return "", false
+}
+
+func dietLegend(active map[fetch.Diet]bool) string {
+	var b strings.Builder
+	for i, d := range dietKeys {
+		box := "[ ]"
+		if active[d] {
+			box = "[x]"
+		}
+		fmt.Fprintf(&b, "%s %d:%s  ", box, i+1, d)
+	}
+	return b.String()
+}
+
+// refreshMenuList rebuilds m.menuList from m.allDishes after a diet toggle or
+// a search query change.
+func (m model) refreshMenuList() model {
+	filtered := filterByDiets(m.allDishes, m.activeDiets)
+	filtered = fuzzyFilterDishes(filtered, m.searchInput.Value())
+	m.menuList.SetItems(dishListItems(filtered))
+	return m
+}
+
+func (m model) renderDishDetail(d fetch.Dish) string {
+	dietLine := "none listed"
+	if len(d.Diets) > 0 {
+		labels := make([]string, len(d.Diets))
+		for i, diet := range d.Diets {
+			labels[i] = string(diet)
+		}
+		dietLine = strings.Join(labels, ", ")
+	}
+
+	return fmt.Sprintf(
+		"%s\n\nIngredients: %s\nDiets: %s\nPrice: %s\nNutrition: %s",
+		d.Name, d.Ingredients, dietLine, formatPrice(d.Price), d.Nutrition,
+	)
+}
+
+func formatPrice(v fetch.PriceValue) string {
+	if v.Unset {
+		return "n/a"
+	}
+	return fmt.Sprintf("student %.2f€ / staff %.2f€ / regular %.2f€", v.Student, v.Staff, v.Regular)
+}
+
+// updateRestaurantView handles key input while currentView is restaurantView,
+// driving the restaurant list -> daily menu -> dish detail drill-down.
+func (m model) updateRestaurantView(msg tea.KeyMsg) (model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	if m.searchInput.Focused() {
+		switch msg.String() {
+		case "enter", "esc":
+			m.searchInput.Blur()
+			return m.refreshMenuList(), nil
+		default:
+			var cmd tea.Cmd
+			m.searchInput, cmd = m.searchInput.Update(msg)
+			return m.refreshMenuList(), cmd
+		}
+	}
+
+	if msg.String() == "q" {
+		return m, tea.Quit
+	}
+
+	switch m.drill {
+	case drillRestaurants:
+		if name, ok := providerForKey(m.providers, msg.String()); ok {
+			if m.enabledProviders[name] {
+				delete(m.enabledProviders, name)
+			} else {
+				m.enabledProviders[name] = true
+			}
+			return m, fetchPage(m.activeProviders())
+		}
+		switch msg.String() {
+		case "enter":
+			if it, ok := m.restaurantList.SelectedItem().(restaurantItem); ok {
+				m.selectedRestaurant = it.restaurant
+				m.allDishes = todaysDishes(it.restaurant, m.day)
+				m.activeDiets = map[fetch.Diet]bool{}
+				m.searchInput.Reset()
+				m.menuList.Title = "Menu: " + it.restaurant.Name
+				m = m.refreshMenuList()
+				m.drill = drillMenu
+			}
+			return m, nil
+		case "f":
+			if it, ok := m.restaurantList.SelectedItem().(restaurantItem); ok {
+				if m.favorites == nil {
+					m.favorites = map[string]bool{}
+				}
+				name := it.restaurant.Name
+				if m.favorites[name] {
+					delete(m.favorites, name)
+				} else {
+					m.favorites[name] = true
+				}
+				merged := mergeByCampus(m.restaurants, m.preferredCampus, m.favorites)
+				m.restaurantList.SetItems(restaurantListItems(merged, m.favorites))
+				m.syncPrefs()
+			}
+			return m, nil
+		case "c":
+			if it, ok := m.restaurantList.SelectedItem().(restaurantItem); ok {
+				// Pressing c again on a restaurant from the already-preferred
+				// campus clears the preference instead of being a no-op.
+				if m.preferredCampus == it.restaurant.Campus {
+					m.preferredCampus = ""
+				} else {
+					m.preferredCampus = it.restaurant.Campus
+				}
+				merged := mergeByCampus(m.restaurants, m.preferredCampus, m.favorites)
+				m.restaurantList.SetItems(restaurantListItems(merged, m.favorites))
+				m.syncPrefs()
+			}
+			return m, nil
+		case "left", "h", "right", "l":
+			return m.switchView(msg.String()), nil
+		}
+		var cmd tea.Cmd
+		m.restaurantList, cmd = m.restaurantList.Update(msg)
+		return m, cmd
+
+	case drillMenu:
+		if diet, ok := dietForKey(msg.String()); ok {
+			if m.activeDiets[diet] {
+				delete(m.activeDiets, diet)
+			} else {
+				m.activeDiets[diet] = true
+			}
+			m = m.refreshMenuList()
+			m.syncPrefs()
+			return m, nil
+		}
+		switch msg.String() {
+		case "esc":
+			m.drill = drillRestaurants
+			return m, nil
+		case "enter":
+			if it, ok := m.menuList.SelectedItem().(dishItem); ok {
+				m.selectedDish = it.dish
+				m.dishViewport.SetContent(m.renderDishDetail(it.dish))
+				m.dishViewport.GotoTop()
+				m.drill = drillDish
+			}
+			return m, nil
+		case "/":
+			m.searchInput.Focus()
+			return m, textinput.Blink
+		}
+		var cmd tea.Cmd
+		m.menuList, cmd = m.menuList.Update(msg)
+		return m, cmd
+
+	case drillDish:
+		switch msg.String() {
+		case "esc":
+			m.drill = drillMenu
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.dishViewport, cmd = m.dishViewport.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// switchView lets left/right still move between the top-level views (home,
+// restaurants, terminal info) while up/down/j/k are busy driving the
+// restaurant drill-down's lists.
+func (m model) switchView(key string) model {
+	switch key {
+	case "left", "h":
+		m.currentView--
+		if m.currentView < 0 {
+			m.currentView = totalViews - 1
+		}
+	case "right", "l":
+		m.currentView++
+		if m.currentView >= totalViews {
+			m.currentView = 0
+		}
+	}
+	m.syncPrefs()
+	return m
+}
+
+// setSize propagates a terminal resize to every sub-component of the
+// restaurant drill-down.
+func (m model) setSize(width, height int) model {
+	listHeight := height - 4
+	if listHeight < 1 {
+		listHeight = 1
+	}
+	m.restaurantList.SetSize(width, listHeight)
+	m.menuList.SetSize(width, listHeight)
+	m.dishViewport.Width = width
+	m.dishViewport.Height = listHeight
+	return m
+}
+
+func newRestaurantList() list.Model {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.Title = "Restaurants"
+	l.SetFilteringEnabled(false)
+	return l
+}
+
+func newMenuList() list.Model {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.Title = "Menu"
+	l.SetFilteringEnabled(false)
+	return l
+}
+
+func newSearchInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = "search dishes..."
+	return ti
+}
+
+func newDishViewport() viewport.Model {
+	return viewport.New(0, 0)
+}